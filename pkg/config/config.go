@@ -22,6 +22,7 @@ type Config struct {
 	Reflection ReflectConfig  `yaml:"reflection"`
 	Platform   PlatformConfig `yaml:"platform"`
 	Stats      StatsConfig    `yaml:"stats"`
+	System     SystemConfig   `yaml:"system"`
 }
 
 // WebUIConfig holds web UI settings
@@ -55,8 +56,15 @@ type PlatformConfig struct {
 
 // StatsConfig holds statistics settings
 type StatsConfig struct {
-	Format   string `yaml:"format"`   // text, json, csv
-	Interval int    `yaml:"interval"` // seconds
+	Format           string    `yaml:"format"`             // text, json, csv
+	Interval         int       `yaml:"interval"`           // seconds
+	LatencyBucketsUs []float64 `yaml:"latency_buckets_us"` // Prometheus histogram bucket boundaries, in microseconds
+}
+
+// SystemConfig holds host/process resource sampling settings
+type SystemConfig struct {
+	Enabled bool  `yaml:"enabled"`
+	Cores   []int `yaml:"cores"` // CPU core indices pinned to the reflector worker threads; empty means all cores
 }
 
 // LoadFile loads configuration from a YAML file
@@ -97,6 +105,9 @@ func (c *Config) applyDefaults() {
 	if c.Stats.Interval == 0 {
 		c.Stats.Interval = 10
 	}
+	if len(c.Stats.LatencyBucketsUs) == 0 {
+		c.Stats.LatencyBucketsUs = []float64{10, 50, 100, 250, 500, 1000, 2500, 5000, 10000}
+	}
 	// TUI enabled by default
 	if !c.TUI.Enabled && c.Interface != "" {
 		c.TUI.Enabled = true
@@ -135,6 +146,12 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("invalid web port: %d", c.WebUI.Port)
 	}
 
+	for _, core := range c.System.Cores {
+		if core < 0 {
+			return fmt.Errorf("invalid system core index: %d", core)
+		}
+	}
+
 	return nil
 }
 
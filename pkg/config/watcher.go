@@ -0,0 +1,159 @@
+/*
+ * watcher.go - hot-reload of the YAML config file via fsnotify and SIGHUP
+ */
+
+package config
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// ReloadResult describes the outcome of applying a reloaded Config to a
+// running reflector: which fields were pushed into the live dataplane,
+// which were rejected (e.g. failed validation at the apply step), and which
+// require a process restart to take effect.
+type ReloadResult struct {
+	Applied  []string
+	Rejected []string
+	Restart  []string
+}
+
+// Watcher observes a YAML config file on disk and re-parses it whenever the
+// file changes or the process receives SIGHUP, handing the validated result
+// to an apply callback supplied by the caller (typically
+// dataplane.Dataplane.UpdateConfig, which knows how to push the
+// live-appliable fields into the C context and diffs against its own stored
+// config rather than needing the previous value passed in).
+type Watcher struct {
+	path  string
+	apply func(new *Config) (*ReloadResult, error)
+	log   *log.Logger
+
+	mu      sync.Mutex
+	current *Config
+
+	fsw    *fsnotify.Watcher
+	sigCh  chan os.Signal
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+// NewWatcher creates a Watcher for path, starting from initial as the
+// currently-applied configuration. apply is called (from the watcher's own
+// goroutine) with the newly-loaded config whenever a reload is triggered,
+// and must return a ReloadResult describing what happened, or an error if
+// the new config couldn't be applied at all.
+func NewWatcher(path string, initial *Config, apply func(new *Config) (*ReloadResult, error)) (*Watcher, error) {
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create config file watcher: %w", err)
+	}
+	if err := fsw.Add(filepath.Dir(path)); err != nil {
+		fsw.Close()
+		return nil, fmt.Errorf("failed to watch %s: %w", path, err)
+	}
+
+	w := &Watcher{
+		path:    path,
+		apply:   apply,
+		log:     log.New(os.Stderr, "config: ", log.LstdFlags),
+		current: initial,
+		fsw:     fsw,
+		sigCh:   make(chan os.Signal, 1),
+		stopCh:  make(chan struct{}),
+		doneCh:  make(chan struct{}),
+	}
+	signal.Notify(w.sigCh, syscall.SIGHUP)
+	return w, nil
+}
+
+// Start launches the watch loop in its own goroutine.
+func (w *Watcher) Start() {
+	go w.run()
+}
+
+// Close stops the watch loop and releases the underlying fsnotify watcher.
+func (w *Watcher) Close() error {
+	close(w.stopCh)
+	<-w.doneCh
+	signal.Stop(w.sigCh)
+	return w.fsw.Close()
+}
+
+// Current returns the configuration as of the last successful reload.
+func (w *Watcher) Current() *Config {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.current
+}
+
+func (w *Watcher) run() {
+	defer close(w.doneCh)
+
+	for {
+		select {
+		case <-w.stopCh:
+			return
+
+		case _, ok := <-w.sigCh:
+			if !ok {
+				return
+			}
+			w.reload("SIGHUP")
+
+		case ev, ok := <-w.fsw.Events:
+			if !ok {
+				return
+			}
+			// Editors commonly replace the file (write-rename) rather than
+			// writing in place, so watch the containing directory and
+			// filter by name instead of relying on a single inode.
+			if filepath.Clean(ev.Name) != filepath.Clean(w.path) {
+				continue
+			}
+			if ev.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+				continue
+			}
+			w.reload("file change")
+
+		case err, ok := <-w.fsw.Errors:
+			if !ok {
+				return
+			}
+			w.log.Printf("watch error: %v", err)
+		}
+	}
+}
+
+func (w *Watcher) reload(trigger string) {
+	newCfg, err := LoadFile(w.path)
+	if err != nil {
+		w.log.Printf("reload triggered by %s: failed to load %s: %v", trigger, w.path, err)
+		return
+	}
+	if err := newCfg.Validate(); err != nil {
+		w.log.Printf("reload triggered by %s: invalid config: %v", trigger, err)
+		return
+	}
+
+	result, err := w.apply(newCfg)
+	if err != nil {
+		w.log.Printf("reload triggered by %s: apply failed: %v", trigger, err)
+		return
+	}
+
+	w.mu.Lock()
+	w.current = newCfg
+	w.mu.Unlock()
+
+	w.log.Printf("reload triggered by %s: applied=%v rejected=%v restart_required=%v",
+		trigger, result.Applied, result.Rejected, result.Restart)
+}
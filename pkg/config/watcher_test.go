@@ -0,0 +1,137 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+var errFakeApply = errors.New("fake apply failure")
+
+// waitForApply polls got until it becomes non-zero or the timeout expires,
+// since the Watcher applies reloads from its own goroutine.
+func waitForApply(t *testing.T, got func() int) int {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if n := got(); n > 0 {
+			return n
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return got()
+}
+
+func writeTestConfig(t *testing.T, path, iface string) {
+	t.Helper()
+	body := "interface: " + iface + "\n" +
+		"filtering:\n  port: 3842\n" +
+		"reflection:\n  mode: all\n"
+	if err := os.WriteFile(path, []byte(body), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+}
+
+func TestWatcherReloadsOnFileChange(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "reflector.yaml")
+	writeTestConfig(t, path, "eth0")
+
+	initial, err := LoadFile(path)
+	if err != nil {
+		t.Fatalf("LoadFile: %v", err)
+	}
+
+	var applyCount int
+	var lastApplied *Config
+	w, err := NewWatcher(path, initial, func(newCfg *Config) (*ReloadResult, error) {
+		applyCount++
+		lastApplied = newCfg
+		return &ReloadResult{Applied: []string{"reflection.mode"}}, nil
+	})
+	if err != nil {
+		t.Fatalf("NewWatcher: %v", err)
+	}
+	w.Start()
+	defer w.Close()
+
+	writeTestConfig(t, path, "eth1")
+
+	if n := waitForApply(t, func() int { return applyCount }); n == 0 {
+		t.Fatal("apply callback was never invoked after file change")
+	}
+	if lastApplied == nil || lastApplied.Interface != "eth1" {
+		t.Fatalf("expected apply to observe interface=eth1, got %+v", lastApplied)
+	}
+	if got := w.Current().Interface; got != "eth1" {
+		t.Fatalf("Current().Interface = %q, want eth1", got)
+	}
+}
+
+func TestWatcherSkipsInvalidConfig(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "reflector.yaml")
+	writeTestConfig(t, path, "eth0")
+
+	initial, err := LoadFile(path)
+	if err != nil {
+		t.Fatalf("LoadFile: %v", err)
+	}
+
+	var applyCount int
+	w, err := NewWatcher(path, initial, func(newCfg *Config) (*ReloadResult, error) {
+		applyCount++
+		return &ReloadResult{}, nil
+	})
+	if err != nil {
+		t.Fatalf("NewWatcher: %v", err)
+	}
+	w.Start()
+	defer w.Close()
+
+	// Invalid reflection mode should fail Validate() and never reach apply.
+	if err := os.WriteFile(path, []byte("interface: eth0\nreflection:\n  mode: bogus\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	time.Sleep(200 * time.Millisecond)
+
+	if applyCount != 0 {
+		t.Fatalf("apply was called %d times for an invalid config", applyCount)
+	}
+	if got := w.Current().Interface; got != "eth0" {
+		t.Fatalf("Current().Interface = %q, want unchanged eth0", got)
+	}
+}
+
+func TestWatcherKeepsCurrentWhenApplyErrors(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "reflector.yaml")
+	writeTestConfig(t, path, "eth0")
+
+	initial, err := LoadFile(path)
+	if err != nil {
+		t.Fatalf("LoadFile: %v", err)
+	}
+
+	var applyCount int
+	w, err := NewWatcher(path, initial, func(newCfg *Config) (*ReloadResult, error) {
+		applyCount++
+		return &ReloadResult{Rejected: []string{"filtering.oui"}}, errFakeApply
+	})
+	if err != nil {
+		t.Fatalf("NewWatcher: %v", err)
+	}
+	w.Start()
+	defer w.Close()
+
+	writeTestConfig(t, path, "eth1")
+
+	if n := waitForApply(t, func() int { return applyCount }); n == 0 {
+		t.Fatal("apply callback was never invoked after file change")
+	}
+	if got := w.Current().Interface; got != "eth0" {
+		t.Fatalf("Current().Interface = %q, want unchanged eth0 since apply returned an error", got)
+	}
+}
@@ -64,6 +64,7 @@ type Stats struct {
 	LatencyAvg       float64
 	LatencyMax       float64
 	LatencyCount     uint64
+	LatencyHistogram LatencyHistogram
 }
 
 // Dataplane wraps the C reflector context
@@ -72,12 +73,15 @@ type Dataplane struct {
 	cfg     *config.Config
 	running bool
 	mu      sync.RWMutex
+
+	closedCh chan struct{}
 }
 
 // New creates a new dataplane instance
 func New(cfg *config.Config) (*Dataplane, error) {
 	dp := &Dataplane{
-		cfg: cfg,
+		cfg:      cfg,
+		closedCh: make(chan struct{}),
 	}
 
 	// Parse OUI
@@ -157,9 +161,21 @@ func (dp *Dataplane) Stop() {
 	dp.running = false
 }
 
-// Close cleans up dataplane resources
+// Close cleans up dataplane resources. It unblocks any in-progress
+// StreamStats consumers, who should treat channel closure the same as a
+// context cancellation.
 func (dp *Dataplane) Close() {
 	dp.Stop()
+
+	dp.mu.Lock()
+	select {
+	case <-dp.closedCh:
+		// Already closed.
+	default:
+		close(dp.closedCh)
+	}
+	dp.mu.Unlock()
+
 	C.reflector_cleanup(&dp.ctx)
 }
 
@@ -168,7 +184,7 @@ func (dp *Dataplane) GetStats() Stats {
 	var cStats C.reflector_stats_t
 	C.reflector_get_stats(&dp.ctx, &cStats)
 
-	return Stats{
+	stats := Stats{
 		PacketsReceived:  uint64(cStats.packets_received),
 		PacketsReflected: uint64(cStats.packets_reflected),
 		BytesReceived:    uint64(cStats.bytes_received),
@@ -183,6 +199,16 @@ func (dp *Dataplane) GetStats() Stats {
 		LatencyMax:       float64(cStats.latency.max_ns) / 1000.0,
 		LatencyCount:     uint64(cStats.latency.count),
 	}
+
+	// The C side marshals its full HDR bucket array across CGO; copy it
+	// into the Go histogram so Percentile() can walk cumulative counts
+	// without further CGO calls.
+	for i := 0; i < histogramBucketCount; i++ {
+		stats.LatencyHistogram.buckets[i] = uint64(cStats.latency.histogram[i])
+	}
+	stats.LatencyHistogram.count = stats.LatencyCount
+
+	return stats
 }
 
 // IsRunning returns whether the dataplane is active
@@ -192,12 +218,100 @@ func (dp *Dataplane) IsRunning() bool {
 	return dp.running
 }
 
+// UpdateConfig applies a hot-reloaded configuration to the running
+// dataplane. Filtering and reflection mode are pushed into the live C
+// context via reflector_update_config; stats, web UI and TUI settings are
+// only recorded in the stored config and reported as applied. Interface and platform
+// (DPDK) settings can't be changed without re-initializing the C context, so
+// they're reported as requiring a restart instead.
+func (dp *Dataplane) UpdateConfig(newCfg *config.Config) (*config.ReloadResult, error) {
+	dp.mu.Lock()
+	defer dp.mu.Unlock()
+
+	result := &config.ReloadResult{}
+	old := dp.cfg
+
+	if old.Interface != newCfg.Interface {
+		result.Restart = append(result.Restart, "interface")
+	}
+	if old.Platform.UseDPDK != newCfg.Platform.UseDPDK || old.Platform.DPDKArgs != newCfg.Platform.DPDKArgs {
+		result.Restart = append(result.Restart, "platform")
+	}
+
+	oui, err := newCfg.ParseOUI()
+	if err != nil {
+		// reflector_update_config is never reached on this path, so none of
+		// the filtering/reflection fields took effect; don't swap in newCfg,
+		// or dp.Config() would report them as live when the C context still
+		// has the old values.
+		result.Rejected = append(result.Rejected, "filtering.port", "filtering.filter_oui", "filtering.oui", "reflection.mode")
+		return result, fmt.Errorf("failed to parse OUI: %w", err)
+	}
+
+	filterOUI := 0
+	if newCfg.Filtering.FilterOUI {
+		filterOUI = 1
+	}
+
+	filterApplied := C.reflector_update_config(&dp.ctx,
+		C.uint16_t(newCfg.Filtering.Port),
+		C.int(filterOUI),
+		C.uint8_t(oui[0]), C.uint8_t(oui[1]), C.uint8_t(oui[2]),
+		C.int(newCfg.ReflectModeInt()),
+	) >= 0
+
+	if filterApplied {
+		result.Applied = append(result.Applied, "filtering.port", "filtering.filter_oui", "filtering.oui", "reflection.mode")
+	} else {
+		result.Rejected = append(result.Rejected, "filtering.port", "filtering.filter_oui", "filtering.oui", "reflection.mode")
+	}
+
+	if old.Stats.Format != newCfg.Stats.Format || old.Stats.Interval != newCfg.Stats.Interval {
+		result.Applied = append(result.Applied, "stats.format", "stats.interval")
+	}
+	if old.WebUI.Enabled != newCfg.WebUI.Enabled {
+		result.Applied = append(result.Applied, "web_ui.enabled")
+	}
+	if old.TUI.Enabled != newCfg.TUI.Enabled {
+		result.Applied = append(result.Applied, "tui.enabled")
+	}
+
+	dp.cfg = mergeAppliedConfig(old, newCfg, filterApplied)
+	return result, nil
+}
+
+// mergeAppliedConfig builds the Config to store as dp.cfg after a reload:
+// everything newCfg changed is adopted, except Filtering/Reflection, which
+// only get adopted when filterApplied is true (reflector_update_config
+// actually pushed them into the C context). Keeping old's Filtering and
+// Reflection on rejection is what stops dp.Config() from reporting fields
+// as live that the C dataplane never received.
+func mergeAppliedConfig(old, newCfg *config.Config, filterApplied bool) *config.Config {
+	merged := *old
+	merged.Verbose = newCfg.Verbose
+	merged.Interface = newCfg.Interface
+	merged.Platform = newCfg.Platform
+	merged.Stats = newCfg.Stats
+	merged.WebUI = newCfg.WebUI
+	merged.TUI = newCfg.TUI
+	merged.System = newCfg.System
+	if filterApplied {
+		merged.Filtering = newCfg.Filtering
+		merged.Reflection = newCfg.Reflection
+	}
+	return &merged
+}
+
 // Interface returns the network interface name
 func (dp *Dataplane) Interface() string {
+	dp.mu.RLock()
+	defer dp.mu.RUnlock()
 	return dp.cfg.Interface
 }
 
 // Config returns the configuration
 func (dp *Dataplane) Config() *config.Config {
+	dp.mu.RLock()
+	defer dp.mu.RUnlock()
 	return dp.cfg
 }
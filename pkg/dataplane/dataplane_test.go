@@ -0,0 +1,53 @@
+package dataplane
+
+import (
+	"testing"
+
+	"github.com/krisarmstrong/reflector-native/pkg/config"
+)
+
+func baseTestConfig() *config.Config {
+	return &config.Config{
+		Interface:  "eth0",
+		Filtering:  config.FilterConfig{Port: 3842, FilterOUI: true, OUI: "00:c0:17"},
+		Reflection: config.ReflectConfig{Mode: "all"},
+		Stats:      config.StatsConfig{Format: "text", Interval: 10},
+	}
+}
+
+func TestMergeAppliedConfigKeepsFilteringOnRejection(t *testing.T) {
+	old := baseTestConfig()
+	newCfg := baseTestConfig()
+	newCfg.Filtering.Port = 9999
+	newCfg.Filtering.OUI = "aa:bb:cc"
+	newCfg.Reflection.Mode = "mac"
+	newCfg.Stats.Interval = 30
+
+	merged := mergeAppliedConfig(old, newCfg, false)
+
+	if merged.Filtering.Port != old.Filtering.Port || merged.Filtering.OUI != old.Filtering.OUI {
+		t.Errorf("expected Filtering to stay at old values when rejected, got %+v", merged.Filtering)
+	}
+	if merged.Reflection.Mode != old.Reflection.Mode {
+		t.Errorf("expected Reflection to stay at old value when rejected, got %+v", merged.Reflection)
+	}
+	if merged.Stats.Interval != newCfg.Stats.Interval {
+		t.Errorf("expected Stats to adopt newCfg regardless of filter rejection, got %+v", merged.Stats)
+	}
+}
+
+func TestMergeAppliedConfigAdoptsFilteringWhenApplied(t *testing.T) {
+	old := baseTestConfig()
+	newCfg := baseTestConfig()
+	newCfg.Filtering.Port = 9999
+	newCfg.Reflection.Mode = "mac"
+
+	merged := mergeAppliedConfig(old, newCfg, true)
+
+	if merged.Filtering.Port != newCfg.Filtering.Port {
+		t.Errorf("expected Filtering.Port to adopt newCfg when applied, got %d", merged.Filtering.Port)
+	}
+	if merged.Reflection.Mode != newCfg.Reflection.Mode {
+		t.Errorf("expected Reflection.Mode to adopt newCfg when applied, got %s", merged.Reflection.Mode)
+	}
+}
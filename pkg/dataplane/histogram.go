@@ -0,0 +1,129 @@
+/*
+ * histogram.go - compact HDR latency histogram marshaled from the C dataplane
+ */
+
+package dataplane
+
+import (
+	"math"
+	"math/bits"
+)
+
+// histogramSubBucketBits controls the sub-buckets per power-of-two range;
+// 64 sub-buckets per 2x range keeps relative error under ~1.6% while
+// staying close to the ~2000-bucket budget for covering 1ns-1s.
+const (
+	histogramSubBucketBits  = 6
+	histogramSubBucketCount = 1 << histogramSubBucketBits
+	histogramMaxExponent    = 30 // 2^30 ns ~= 1.07s
+	histogramBucketCount    = (histogramMaxExponent + 1) * histogramSubBucketCount
+)
+
+// LatencyHistogram is a high-dynamic-range histogram of per-packet
+// reflection latencies, marshaled from the C dataplane's
+// reflector_stats_t.latency.histogram bucket array. Buckets are indexed by
+// (exponent, mantissa) where exponent = floor(log2(ns)) and mantissa
+// selects one of histogramSubBucketCount equal slices of
+// [2^exponent, 2^(exponent+1)) ns, covering ~1ns-1s with bounded relative
+// error.
+type LatencyHistogram struct {
+	buckets [histogramBucketCount]uint64
+	count   uint64
+}
+
+// bucketIndex returns the bucket covering ns nanoseconds, mirroring the C
+// side's O(1) bit-scan lookup.
+func bucketIndex(ns uint64) int {
+	if ns < 1 {
+		ns = 1
+	}
+
+	exponent := 63 - bits.LeadingZeros64(ns)
+	if exponent > histogramMaxExponent {
+		exponent = histogramMaxExponent
+	}
+
+	lower := uint64(1) << uint(exponent)
+	// When exponent was clamped above, ns may be far larger than 2*lower, in
+	// which case (ns-lower)*histogramSubBucketCount overflows uint64 and
+	// wraps into an earlier bucket. Clamp ns itself into the bucket's range
+	// first so out-of-range samples land in the top bucket as intended.
+	if upper := lower << 1; ns >= upper {
+		ns = upper - 1
+	}
+	mantissa := (ns - lower) * histogramSubBucketCount / lower
+	if mantissa >= histogramSubBucketCount {
+		mantissa = histogramSubBucketCount - 1
+	}
+
+	return exponent*histogramSubBucketCount + int(mantissa)
+}
+
+// bucketUpperBoundNs returns the upper edge, in nanoseconds, of bucket i.
+func bucketUpperBoundNs(i int) float64 {
+	exponent := i / histogramSubBucketCount
+	mantissa := i % histogramSubBucketCount
+
+	lower := math.Ldexp(1, exponent)
+	step := lower / histogramSubBucketCount
+	return lower + float64(mantissa+1)*step
+}
+
+// Record adds a single latency sample in nanoseconds.
+func (h *LatencyHistogram) Record(ns uint64) {
+	h.buckets[bucketIndex(ns)]++
+	h.count++
+}
+
+// Count returns the total number of recorded samples.
+func (h *LatencyHistogram) Count() uint64 {
+	return h.count
+}
+
+// Percentile returns the latency in microseconds at or below which p
+// percent (0-100) of recorded samples fall, at bucket granularity: the result
+// is the upper edge of the bucket in which the cumulative count reaches p.
+func (h *LatencyHistogram) Percentile(p float64) float64 {
+	if h.count == 0 {
+		return 0
+	}
+
+	target := uint64(math.Ceil(p / 100 * float64(h.count)))
+	if target == 0 {
+		target = 1
+	}
+
+	var cumulative uint64
+	for i, c := range h.buckets {
+		cumulative += c
+		if cumulative >= target {
+			return bucketUpperBoundNs(i) / 1000.0
+		}
+	}
+	return bucketUpperBoundNs(len(h.buckets)-1) / 1000.0
+}
+
+// CumulativeCount returns the number of recorded samples in buckets whose
+// upper edge is at or below thresholdNs nanoseconds; samples in a bucket that
+// straddles thresholdNs are not counted. It's used to build Prometheus-style
+// cumulative histogram buckets from a fixed set of boundaries.
+func (h *LatencyHistogram) CumulativeCount(thresholdNs float64) uint64 {
+	var cumulative uint64
+	for i, c := range h.buckets {
+		if bucketUpperBoundNs(i) > thresholdNs {
+			break
+		}
+		cumulative += c
+	}
+	return cumulative
+}
+
+// Merge folds other's bucket counts into h, losslessly combining two
+// histograms built with the same layout (e.g. from multiple reflector
+// instances).
+func (h *LatencyHistogram) Merge(other *LatencyHistogram) {
+	for i, c := range other.buckets {
+		h.buckets[i] += c
+	}
+	h.count += other.count
+}
@@ -0,0 +1,65 @@
+package dataplane
+
+import "testing"
+
+func TestBucketIndexClampsTopBucket(t *testing.T) {
+	tests := []struct {
+		name string
+		ns   uint64
+		want int
+	}{
+		{"zero clamps to bucket for 1ns", 0, bucketIndex(1)},
+		{"one nanosecond", 1, 0},
+		{"exactly max exponent lower bound", uint64(1) << histogramMaxExponent, histogramMaxExponent * histogramSubBucketCount},
+		{"far beyond max exponent clamps to last bucket", 288230377226668673, histogramBucketCount - 1},
+		{"max uint64 clamps to last bucket", ^uint64(0), histogramBucketCount - 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := bucketIndex(tc.ns); got != tc.want {
+				t.Errorf("bucketIndex(%d) = %d, want %d", tc.ns, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLatencyHistogramPercentile(t *testing.T) {
+	var h LatencyHistogram
+	for _, ns := range []uint64{1000, 2000, 3000, 4000, 100000} {
+		h.Record(ns)
+	}
+
+	if got := h.Percentile(50); got <= 0 {
+		t.Errorf("Percentile(50) = %v, want > 0", got)
+	}
+	if p50, p99 := h.Percentile(50), h.Percentile(99); p99 < p50 {
+		t.Errorf("Percentile(99) = %v should be >= Percentile(50) = %v", p99, p50)
+	}
+	if got := h.Percentile(100); got < h.Percentile(99) {
+		t.Errorf("Percentile(100) = %v should be >= Percentile(99) = %v", got, h.Percentile(99))
+	}
+}
+
+func TestLatencyHistogramPercentileEmpty(t *testing.T) {
+	var h LatencyHistogram
+	if got := h.Percentile(50); got != 0 {
+		t.Errorf("Percentile(50) on empty histogram = %v, want 0", got)
+	}
+}
+
+func TestLatencyHistogramMerge(t *testing.T) {
+	var a, b LatencyHistogram
+	a.Record(1000)
+	a.Record(2000)
+	b.Record(3000)
+
+	a.Merge(&b)
+
+	if got := a.Count(); got != 3 {
+		t.Errorf("Count() after Merge = %d, want 3", got)
+	}
+	if got := a.CumulativeCount(3500); got != 3 {
+		t.Errorf("CumulativeCount(3500) after Merge = %d, want 3", got)
+	}
+}
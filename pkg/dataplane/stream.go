@@ -0,0 +1,85 @@
+/*
+ * stream.go - deadline-bounded and streaming variants of GetStats
+ *
+ * The deadline is threaded through as a GetStatsContext argument rather than
+ * stored on Dataplane, so concurrent callers (e.g. a TUI and a web
+ * StatsBroadcaster sharing one Dataplane) each get their own bound instead
+ * of racing to overwrite a shared field.
+ */
+
+package dataplane
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+)
+
+// GetStatsContext returns current statistics, bounded by ctx and deadline
+// (whichever fires first). A zero deadline means no additional bound beyond
+// ctx. The CGO call itself can't be interrupted once started, so on timeout
+// GetStatsContext returns os.ErrDeadlineExceeded (or ctx.Err()) immediately
+// and simply drops the result when reflector_get_stats eventually returns.
+func (dp *Dataplane) GetStatsContext(ctx context.Context, deadline time.Time) (Stats, error) {
+	if !deadline.IsZero() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, deadline)
+		defer cancel()
+	}
+
+	resultCh := make(chan Stats, 1)
+	go func() {
+		resultCh <- dp.GetStats()
+	}()
+
+	select {
+	case stats := <-resultCh:
+		return stats, nil
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return Stats{}, os.ErrDeadlineExceeded
+		}
+		return Stats{}, ctx.Err()
+	}
+}
+
+// StreamStats samples GetStatsContext at interval and sends each result on
+// the returned channel, which is closed when ctx is done or the Dataplane
+// is Close()d. Each sample is bounded to one interval so a stuck
+// reflector_get_stats call can't pile up behind a slow consumer; a sample
+// that errors (e.g. that deadline firing) ends the stream rather than
+// sending a zero-value Stats.
+func (dp *Dataplane) StreamStats(ctx context.Context, interval time.Duration) <-chan Stats {
+	out := make(chan Stats)
+
+	go func() {
+		defer close(out)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-dp.closedCh:
+				return
+			case <-ticker.C:
+				stats, err := dp.GetStatsContext(ctx, time.Now().Add(interval))
+				if err != nil {
+					return
+				}
+				select {
+				case out <- stats:
+				case <-ctx.Done():
+					return
+				case <-dp.closedCh:
+					return
+				}
+			}
+		}
+	}()
+
+	return out
+}
@@ -0,0 +1,36 @@
+//go:build darwin
+
+package sysstats
+
+import (
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// readNICCounters shells out to `netstat -I <iface> -b`, since macOS has no
+// /proc/net/dev equivalent, and parses the link-level packet columns.
+func readNICCounters(iface string) (rxPackets, txPackets uint64, queues int, err error) {
+	out, err := exec.Command("netstat", "-I", iface, "-b").Output()
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("netstat -I %s failed: %w", iface, err)
+	}
+
+	lines := strings.Split(string(out), "\n")
+	if len(lines) < 2 {
+		return 0, 0, 0, fmt.Errorf("unexpected netstat output for %s", iface)
+	}
+
+	// Header: Name Mtu Network Address Ipkts Ierrs Ibytes Opkts Oerrs Obytes Coll
+	fields := strings.Fields(lines[1])
+	if len(fields) < 10 {
+		return 0, 0, 0, fmt.Errorf("unexpected netstat column count for %s", iface)
+	}
+
+	rx, _ := strconv.ParseUint(fields[4], 10, 64)
+	tx, _ := strconv.ParseUint(fields[7], 10, 64)
+
+	// netstat doesn't expose per-queue driver detail on macOS.
+	return rx, tx, 0, nil
+}
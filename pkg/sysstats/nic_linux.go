@@ -0,0 +1,59 @@
+//go:build linux
+
+package sysstats
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// readNICCounters parses /proc/net/dev for iface's packet counters. The
+// driver queue count is approximated from the per-queue directories under
+// /sys/class/net/<iface>/queues.
+func readNICCounters(iface string) (rxPackets, txPackets uint64, queues int, err error) {
+	f, err := os.Open("/proc/net/dev")
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		name, fields, ok := splitNetDevLine(scanner.Text())
+		if !ok || name != iface {
+			continue
+		}
+		if len(fields) < 10 {
+			return 0, 0, 0, fmt.Errorf("unexpected /proc/net/dev format for %s", iface)
+		}
+		rx, _ := strconv.ParseUint(fields[1], 10, 64)
+		tx, _ := strconv.ParseUint(fields[9], 10, 64)
+		return rx, tx, countQueues(iface), nil
+	}
+	return 0, 0, 0, fmt.Errorf("interface %s not found in /proc/net/dev", iface)
+}
+
+func splitNetDevLine(line string) (name string, fields []string, ok bool) {
+	parts := strings.SplitN(line, ":", 2)
+	if len(parts) != 2 {
+		return "", nil, false
+	}
+	return strings.TrimSpace(parts[0]), strings.Fields(parts[1]), true
+}
+
+func countQueues(iface string) int {
+	entries, err := os.ReadDir(fmt.Sprintf("/sys/class/net/%s/queues", iface))
+	if err != nil {
+		return 0
+	}
+	n := 0
+	for _, e := range entries {
+		if strings.HasPrefix(e.Name(), "rx-") {
+			n++
+		}
+	}
+	return n
+}
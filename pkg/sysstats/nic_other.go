@@ -0,0 +1,10 @@
+//go:build !linux && !darwin
+
+package sysstats
+
+import "fmt"
+
+// readNICCounters has no implementation outside Linux and macOS.
+func readNICCounters(iface string) (rxPackets, txPackets uint64, queues int, err error) {
+	return 0, 0, 0, fmt.Errorf("NIC counters are not supported on this platform")
+}
@@ -0,0 +1,163 @@
+/*
+ * sysstats.go - host and process resource metrics sampled alongside dataplane stats
+ *
+ * Lets operators correlate TxErrors/RxInvalid with sudden host load or
+ * softirq saturation without leaving the TUI or web UI.
+ */
+
+package sysstats
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/shirou/gopsutil/v3/host"
+	"github.com/shirou/gopsutil/v3/load"
+	"github.com/shirou/gopsutil/v3/process"
+
+	"github.com/krisarmstrong/reflector-native/pkg/config"
+)
+
+// Snapshot is a point-in-time read of host and process resource usage.
+type Snapshot struct {
+	Load1  float64
+	Load5  float64
+	Load15 float64
+
+	// CPUPercent holds per-core utilization for the cores named in
+	// config.SystemConfig.Cores, in that order. Empty Cores means all cores.
+	CPUPercent []float64
+
+	RSSBytes    uint64
+	CtxSwitches uint64
+	HostUptime  uint64
+
+	NICName         string
+	NICRxPackets    uint64
+	NICTxPackets    uint64
+	NICDriverQueues int
+
+	SampledAt time.Time
+}
+
+// Sampler periodically collects a Snapshot in its own goroutine and caches
+// it behind a mutex, so callers (the TUI render loop, /api/stats handler)
+// never block on /proc reads or shelling out to netstat.
+type Sampler struct {
+	cfg   config.SystemConfig
+	iface string
+	proc  *process.Process
+
+	mu     sync.RWMutex
+	latest Snapshot
+
+	stopCh   chan struct{}
+	doneCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// NewSampler creates a Sampler that will report NIC counters for iface.
+func NewSampler(cfg config.SystemConfig, iface string) (*Sampler, error) {
+	proc, err := process.NewProcess(int32(os.Getpid()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open process handle for self: %w", err)
+	}
+
+	return &Sampler{
+		cfg:    cfg,
+		iface:  iface,
+		proc:   proc,
+		stopCh: make(chan struct{}),
+		doneCh: make(chan struct{}),
+	}, nil
+}
+
+// Start takes an initial sample synchronously (so Latest is never a zero
+// value once Start returns) and then launches the sampling goroutine.
+func (s *Sampler) Start(interval time.Duration) {
+	s.sample()
+	go s.run(interval)
+}
+
+// Stop halts the sampling goroutine. Safe to call more than once.
+func (s *Sampler) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+		<-s.doneCh
+	})
+}
+
+// Latest returns the most recently collected Snapshot.
+func (s *Sampler) Latest() Snapshot {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.latest
+}
+
+func (s *Sampler) run(interval time.Duration) {
+	defer close(s.doneCh)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+			s.sample()
+		}
+	}
+}
+
+func (s *Sampler) sample() {
+	snap := Snapshot{SampledAt: time.Now()}
+
+	if avg, err := load.Avg(); err == nil {
+		snap.Load1, snap.Load5, snap.Load15 = avg.Load1, avg.Load5, avg.Load15
+	}
+
+	if percents, err := cpu.Percent(0, true); err == nil {
+		snap.CPUPercent = selectCores(percents, s.cfg.Cores)
+	}
+
+	if mem, err := s.proc.MemoryInfo(); err == nil {
+		snap.RSSBytes = mem.RSS
+	}
+	if sw, err := s.proc.NumCtxSwitches(); err == nil {
+		snap.CtxSwitches = uint64(sw.Voluntary + sw.Involuntary)
+	}
+
+	if info, err := host.Info(); err == nil {
+		snap.HostUptime = info.Uptime
+	}
+
+	if rx, tx, queues, err := readNICCounters(s.iface); err == nil {
+		snap.NICName = s.iface
+		snap.NICRxPackets = rx
+		snap.NICTxPackets = tx
+		snap.NICDriverQueues = queues
+	}
+
+	s.mu.Lock()
+	s.latest = snap
+	s.mu.Unlock()
+}
+
+// selectCores returns percents restricted to the indices in cores, in
+// order, or all of percents if cores is empty.
+func selectCores(percents []float64, cores []int) []float64 {
+	if len(cores) == 0 {
+		return percents
+	}
+	selected := make([]float64, 0, len(cores))
+	for _, c := range cores {
+		if c >= 0 && c < len(percents) {
+			selected = append(selected, percents[c])
+		}
+	}
+	return selected
+}
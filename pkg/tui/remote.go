@@ -0,0 +1,134 @@
+/*
+ * remote.go - drive the TUI from a remote reflector's stats stream
+ */
+
+package tui
+
+import (
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/krisarmstrong/reflector-native/pkg/dataplane"
+)
+
+// remoteStatsFrame mirrors the fields of web.StatsResponse that the TUI
+// actually renders. It's redeclared here rather than importing pkg/web so
+// the TUI only depends on the wire shape, not the server package.
+type remoteStatsFrame struct {
+	Interface        string `json:"interface"`
+	PacketsReceived  uint64 `json:"packets_received"`
+	PacketsReflected uint64 `json:"packets_reflected"`
+	BytesReceived    uint64 `json:"bytes_received"`
+	BytesReflected   uint64 `json:"bytes_reflected"`
+	TxErrors         uint64 `json:"tx_errors"`
+	RxInvalid        uint64 `json:"rx_invalid"`
+	Signatures       struct {
+		ProbeOT uint64 `json:"probeot"`
+		DataOT  uint64 `json:"dataot"`
+		Latency uint64 `json:"latency"`
+	} `json:"signatures"`
+	Latency struct {
+		MinUs float64 `json:"min_us"`
+		AvgUs float64 `json:"avg_us"`
+		MaxUs float64 `json:"max_us"`
+		Count uint64  `json:"count"`
+	} `json:"latency"`
+}
+
+// RemoteStatsClient implements StatsSource by consuming a remote reflector's
+// /api/stats/stream WebSocket feed, so the TUI can drive off another
+// instance's dataplane instead of one in this process.
+type RemoteStatsClient struct {
+	conn *websocket.Conn
+
+	mu     sync.RWMutex
+	iface  string
+	latest dataplane.Stats
+
+	closeCh  chan struct{}
+	closeErr error
+}
+
+// DialRemoteStatsClient connects to addr (e.g. "localhost:8080") and begins
+// reading StatsResponse frames from its /api/stats/stream endpoint.
+func DialRemoteStatsClient(addr string) (*RemoteStatsClient, error) {
+	u := url.URL{Scheme: "ws", Host: addr, Path: "/api/stats/stream"}
+	conn, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", u.String(), err)
+	}
+
+	c := &RemoteStatsClient{
+		conn:    conn,
+		closeCh: make(chan struct{}),
+	}
+	go c.readLoop()
+	return c, nil
+}
+
+func (c *RemoteStatsClient) readLoop() {
+	defer close(c.closeCh)
+
+	for {
+		var frame remoteStatsFrame
+		if err := c.conn.ReadJSON(&frame); err != nil {
+			c.mu.Lock()
+			c.closeErr = err
+			c.mu.Unlock()
+			return
+		}
+
+		stats := dataplane.Stats{
+			PacketsReceived:  frame.PacketsReceived,
+			PacketsReflected: frame.PacketsReflected,
+			BytesReceived:    frame.BytesReceived,
+			BytesReflected:   frame.BytesReflected,
+			TxErrors:         frame.TxErrors,
+			RxInvalid:        frame.RxInvalid,
+			SigProbeOT:       frame.Signatures.ProbeOT,
+			SigDataOT:        frame.Signatures.DataOT,
+			SigLatency:       frame.Signatures.Latency,
+			LatencyMin:       frame.Latency.MinUs,
+			LatencyAvg:       frame.Latency.AvgUs,
+			LatencyMax:       frame.Latency.MaxUs,
+			LatencyCount:     frame.Latency.Count,
+		}
+
+		c.mu.Lock()
+		c.iface = frame.Interface
+		c.latest = stats
+		c.mu.Unlock()
+	}
+}
+
+// GetStats returns the most recently received stats frame.
+func (c *RemoteStatsClient) GetStats() dataplane.Stats {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.latest
+}
+
+// Interface returns the remote reflector's interface name, once at least one
+// frame has been received.
+func (c *RemoteStatsClient) Interface() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.iface == "" {
+		return "(connecting)"
+	}
+	return c.iface
+}
+
+// Close tears down the underlying WebSocket connection.
+func (c *RemoteStatsClient) Close() error {
+	_ = c.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	err := c.conn.Close()
+	select {
+	case <-c.closeCh:
+	case <-time.After(time.Second):
+	}
+	return err
+}
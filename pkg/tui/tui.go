@@ -7,18 +7,29 @@
 package tui
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"sync"
 	"time"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/krisarmstrong/reflector-native/pkg/dataplane"
+	"github.com/krisarmstrong/reflector-native/pkg/sysstats"
 	"github.com/rivo/tview"
 )
 
+// StatsSource supplies the data the TUI renders. dataplane.Dataplane
+// satisfies it directly; RemoteStatsClient satisfies it by streaming from
+// another reflector's web UI instead of a local Dataplane.
+type StatsSource interface {
+	GetStats() dataplane.Stats
+	Interface() string
+}
+
 // App holds the TUI application state
 type App struct {
-	dp        *dataplane.Dataplane
+	dp        StatsSource
 	app       *tview.Application
 	statsView *tview.TextView
 	sigView   *tview.TextView
@@ -27,12 +38,24 @@ type App struct {
 	startTime time.Time
 	stopChan  chan struct{}
 	stopOnce  sync.Once // Prevent double-close panic
+
+	sysSampler *sysstats.Sampler
 }
 
-// New creates a new TUI application
+// New creates a new TUI application driven by a local Dataplane.
 func New(dp *dataplane.Dataplane) *App {
+	return newApp(dp)
+}
+
+// NewRemote creates a TUI application driven by a remote reflector's
+// /api/stats/stream WebSocket feed instead of a local Dataplane.
+func NewRemote(client *RemoteStatsClient) *App {
+	return newApp(client)
+}
+
+func newApp(src StatsSource) *App {
 	return &App{
-		dp:        dp,
+		dp:        src,
 		app:       tview.NewApplication(),
 		startTime: time.Now(),
 		stopChan:  make(chan struct{}),
@@ -107,32 +130,59 @@ func (a *App) Run() error {
 	return a.app.SetRoot(mainFlex, true).EnableMouse(false).Run()
 }
 
+// EnableSysStats wires a sysstats.Sampler into the TUI so the signatures
+// panel also shows host/process resource usage alongside dataplane stats.
+func (a *App) EnableSysStats(sampler *sysstats.Sampler) {
+	a.sysSampler = sampler
+}
+
 // Stop signals the TUI to exit
 func (a *App) Stop() {
 	a.stopOnce.Do(func() {
 		close(a.stopChan)
+		if closer, ok := a.dp.(io.Closer); ok {
+			closer.Close()
+		}
 		a.app.Stop()
 	})
 }
 
-// updateLoop periodically refreshes the display
+// statsPollInterval is how often the display refreshes.
+const statsPollInterval = 500 * time.Millisecond
+
+// updateLoop periodically refreshes the display. A local Dataplane source
+// drives this off StreamStats, which honors ctx cancellation and Close(); a
+// RemoteStatsClient (or anything else satisfying StatsSource) falls back to
+// plain polling since it has no context-aware streaming API of its own.
 func (a *App) updateLoop() {
-	ticker := time.NewTicker(500 * time.Millisecond)
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-a.stopChan
+		cancel()
+	}()
+
+	if dp, ok := a.dp.(*dataplane.Dataplane); ok {
+		for stats := range dp.StreamStats(ctx, statsPollInterval) {
+			a.renderStats(stats)
+		}
+		return
+	}
+
+	ticker := time.NewTicker(statsPollInterval)
 	defer ticker.Stop()
 
 	for {
 		select {
-		case <-a.stopChan:
+		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			a.updateStats()
+			a.renderStats(a.dp.GetStats())
 		}
 	}
 }
 
-// updateStats refreshes all stat panels
-func (a *App) updateStats() {
-	stats := a.dp.GetStats()
+// renderStats refreshes all stat panels from a single Stats sample.
+func (a *App) renderStats(stats dataplane.Stats) {
 	elapsed := time.Since(a.startTime).Seconds()
 
 	// Calculate rates
@@ -171,10 +221,38 @@ func (a *App) updateStats() {
 		formatNumber(stats.SigDataOT),
 		formatNumber(stats.SigLatency),
 	)
+	if a.sysSampler != nil {
+		sys := a.sysSampler.Latest()
+		sigText += fmt.Sprintf(
+			"\n\n[cyan]Load:[white]    %.2f/%.2f/%.2f\n"+
+				"[cyan]CPU:[white]     %.1f%%\n"+
+				"[cyan]RSS:[white]     %s\n"+
+				"[cyan]NIC Qs:[white]  %d",
+			sys.Load1, sys.Load5, sys.Load15,
+			avgCPUPercent(sys.CPUPercent),
+			formatBytes(sys.RSSBytes),
+			sys.NICDriverQueues,
+		)
+	}
 
 	// Latency stats
 	latText := ""
-	if stats.LatencyCount > 0 {
+	switch {
+	case stats.LatencyCount == 0:
+		latText = "[gray]No latency data\n(use --latency)"
+	case stats.LatencyHistogram.Count() > 0:
+		latText = fmt.Sprintf(
+			"[magenta]p50:[white]   %.2f µs\n"+
+				"[magenta]p99:[white]   %.2f µs\n"+
+				"[magenta]p99.9:[white] %.2f µs\n"+
+				"[magenta]Count:[white] %s",
+			stats.LatencyHistogram.Percentile(50),
+			stats.LatencyHistogram.Percentile(99),
+			stats.LatencyHistogram.Percentile(99.9),
+			formatNumber(stats.LatencyCount),
+		)
+	default:
+		// Remote sources (RemoteStatsClient) only carry min/avg/max today.
 		latText = fmt.Sprintf(
 			"[magenta]Min:[white]   %.2f µs\n"+
 				"[magenta]Avg:[white]   %.2f µs\n"+
@@ -185,8 +263,6 @@ func (a *App) updateStats() {
 			stats.LatencyMax,
 			formatNumber(stats.LatencyCount),
 		)
-	} else {
-		latText = "[gray]No latency data\n(use --latency)"
 	}
 
 	// Update views on main thread
@@ -228,6 +304,17 @@ func formatBytes(n uint64) string {
 	return fmt.Sprintf("%d B", n)
 }
 
+func avgCPUPercent(percents []float64) float64 {
+	if len(percents) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, p := range percents {
+		sum += p
+	}
+	return sum / float64(len(percents))
+}
+
 func formatDuration(d time.Duration) string {
 	hours := int(d.Hours())
 	minutes := int(d.Minutes()) % 60
@@ -0,0 +1,112 @@
+/*
+ * broadcaster.go - fan-out of periodic dataplane stats to many consumers
+ */
+
+package web
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/krisarmstrong/reflector-native/pkg/dataplane"
+)
+
+// minStreamInterval is the fastest cadence the stats stream will ever emit
+// at, regardless of how short config.StatsConfig.Interval is set.
+const minStreamInterval = 200 * time.Millisecond
+
+// StatsBroadcaster fans a Dataplane's StreamStats out to any number of
+// subscribers. Slow consumers have ticks dropped rather than blocking the
+// producer or each other.
+type StatsBroadcaster struct {
+	dp       dataplaneSource
+	interval time.Duration
+
+	mu   sync.Mutex
+	subs map[chan dataplane.Stats]struct{}
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	doneCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// NewStatsBroadcaster creates a broadcaster that samples dp at interval,
+// floored at minStreamInterval so a misconfigured or zero interval can't
+// spin the producer goroutine.
+func NewStatsBroadcaster(dp dataplaneSource, interval time.Duration) *StatsBroadcaster {
+	if interval < minStreamInterval {
+		interval = minStreamInterval
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &StatsBroadcaster{
+		dp:       dp,
+		interval: interval,
+		subs:     make(map[chan dataplane.Stats]struct{}),
+		ctx:      ctx,
+		cancel:   cancel,
+		doneCh:   make(chan struct{}),
+	}
+}
+
+// Start launches the fan-out goroutine. Call once per broadcaster.
+func (b *StatsBroadcaster) Start() {
+	go b.run()
+}
+
+// Subscribe registers a new client and returns a buffered channel of Stats
+// updates. The caller must Unsubscribe when it stops reading to release the
+// channel.
+func (b *StatsBroadcaster) Subscribe() chan dataplane.Stats {
+	ch := make(chan dataplane.Stats, 4)
+	b.mu.Lock()
+	b.subs[ch] = struct{}{}
+	b.mu.Unlock()
+	return ch
+}
+
+// Unsubscribe removes a client and closes its channel.
+func (b *StatsBroadcaster) Unsubscribe(ch chan dataplane.Stats) {
+	b.mu.Lock()
+	if _, ok := b.subs[ch]; ok {
+		delete(b.subs, ch)
+		close(ch)
+	}
+	b.mu.Unlock()
+}
+
+// Stop halts the fan-out goroutine and closes all subscriber channels. Stop
+// is safe to call more than once and blocks until the goroutine has exited.
+func (b *StatsBroadcaster) Stop() {
+	b.stopOnce.Do(func() {
+		b.cancel()
+		<-b.doneCh
+	})
+}
+
+func (b *StatsBroadcaster) run() {
+	defer close(b.doneCh)
+
+	// StreamStats closes its channel on ctx cancellation (our Stop) or if
+	// the underlying Dataplane is Close()d concurrently, so this loop needs
+	// no separate stop-channel coordination of its own.
+	for stats := range b.dp.StreamStats(b.ctx, b.interval) {
+		b.mu.Lock()
+		for ch := range b.subs {
+			select {
+			case ch <- stats:
+			default:
+				// Slow consumer: drop this tick rather than block the producer.
+			}
+		}
+		b.mu.Unlock()
+	}
+
+	b.mu.Lock()
+	for ch := range b.subs {
+		delete(b.subs, ch)
+		close(ch)
+	}
+	b.mu.Unlock()
+}
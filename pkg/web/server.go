@@ -7,25 +7,58 @@
 package web
 
 import (
+	"context"
 	"embed"
 	"encoding/json"
 	"fmt"
 	"io/fs"
 	"net/http"
+	"os"
+	"strings"
 	"time"
 
+	"github.com/gorilla/websocket"
+	"github.com/krisarmstrong/reflector-native/pkg/config"
 	"github.com/krisarmstrong/reflector-native/pkg/dataplane"
+	"github.com/krisarmstrong/reflector-native/pkg/sysstats"
 )
 
+// statsStreamPingInterval is how often the server sends a WebSocket ping to
+// detect dead clients; it must stay comfortably under statsStreamPongWait.
+const (
+	statsStreamPingInterval = 30 * time.Second
+	statsStreamPongWait     = 60 * time.Second
+)
+
+// upgrader upgrades /api/stats/stream requests to WebSocket connections.
+// Origin checking is left permissive to match the /api/stats, /api/config
+// and /metrics handlers, which set Access-Control-Allow-Origin: *.
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
 //go:embed dist/*
 var reactApp embed.FS
 
+// dataplaneSource is the subset of *dataplane.Dataplane that Server depends
+// on. It lets tests substitute a fake instead of a real CGO-backed
+// Dataplane, which can't be constructed without the reflector C library.
+type dataplaneSource interface {
+	GetStats() dataplane.Stats
+	Interface() string
+	IsRunning() bool
+	Config() *config.Config
+	StreamStats(ctx context.Context, interval time.Duration) <-chan dataplane.Stats
+}
+
 // Server holds the web server state
 type Server struct {
-	dp        *dataplane.Dataplane
-	port      int
-	startTime time.Time
-	mux       *http.ServeMux
+	dp          dataplaneSource
+	port        int
+	startTime   time.Time
+	mux         *http.ServeMux
+	broadcaster *StatsBroadcaster
+	sysSampler  *sysstats.Sampler
 }
 
 // StatsResponse is the JSON structure for stats API
@@ -48,12 +81,31 @@ type StatsResponse struct {
 		Latency uint64 `json:"latency"`
 	} `json:"signatures"`
 	Latency struct {
-		MinUs   float64 `json:"min_us"`
-		AvgUs   float64 `json:"avg_us"`
-		MaxUs   float64 `json:"max_us"`
-		Count   uint64  `json:"count"`
-		Enabled bool    `json:"enabled"`
+		MinUs       float64            `json:"min_us"`
+		AvgUs       float64            `json:"avg_us"`
+		MaxUs       float64            `json:"max_us"`
+		Count       uint64             `json:"count"`
+		Enabled     bool               `json:"enabled"`
+		Percentiles map[string]float64 `json:"percentiles,omitempty"`
 	} `json:"latency"`
+	System *SystemStatsResponse `json:"system,omitempty"`
+}
+
+// SystemStatsResponse is the JSON structure for host/process resource usage,
+// sampled by pkg/sysstats. It's only populated when config.SystemConfig.Enabled
+// is set.
+type SystemStatsResponse struct {
+	Load1           float64   `json:"load1"`
+	Load5           float64   `json:"load5"`
+	Load15          float64   `json:"load15"`
+	CPUPercent      []float64 `json:"cpu_percent"`
+	RSSBytes        uint64    `json:"rss_bytes"`
+	CtxSwitches     uint64    `json:"ctx_switches"`
+	HostUptime      uint64    `json:"host_uptime_seconds"`
+	NICName         string    `json:"nic_name,omitempty"`
+	NICRxPackets    uint64    `json:"nic_rx_packets"`
+	NICTxPackets    uint64    `json:"nic_tx_packets"`
+	NICDriverQueues int       `json:"nic_driver_queues"`
 }
 
 // ConfigResponse is the JSON structure for config API
@@ -73,18 +125,32 @@ type ConfigResponse struct {
 }
 
 // New creates a new web server
-func New(dp *dataplane.Dataplane, port int) *Server {
+func New(dp dataplaneSource, port int) *Server {
 	s := &Server{
-		dp:        dp,
-		port:      port,
-		startTime: time.Now(),
-		mux:       http.NewServeMux(),
+		dp:          dp,
+		port:        port,
+		startTime:   time.Now(),
+		mux:         http.NewServeMux(),
+		broadcaster: NewStatsBroadcaster(dp, time.Duration(dp.Config().Stats.Interval)*time.Second),
+	}
+	s.broadcaster.Start()
+
+	if dp.Config().System.Enabled {
+		sampler, err := sysstats.NewSampler(dp.Config().System, dp.Config().Interface)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "sysstats: failed to start sampler: %v\n", err)
+		} else {
+			sampler.Start(time.Duration(dp.Config().Stats.Interval) * time.Second)
+			s.sysSampler = sampler
+		}
 	}
 
 	// API routes
 	s.mux.HandleFunc("/api/stats", s.handleStats)
+	s.mux.HandleFunc("/api/stats/stream", s.handleStatsStream)
 	s.mux.HandleFunc("/api/config", s.handleConfig)
 	s.mux.HandleFunc("/api/health", s.handleHealth)
+	s.mux.HandleFunc("/metrics", s.handleMetrics)
 
 	// Serve embedded React app
 	distFS, err := fs.Sub(reactApp, "dist")
@@ -104,6 +170,16 @@ func (s *Server) Start() error {
 	return http.ListenAndServe(addr, s.mux)
 }
 
+// Close stops the stats broadcaster and system sampler, disconnecting any
+// subscribed /api/stats/stream clients. Call it when the Server's lifetime
+// ends.
+func (s *Server) Close() {
+	s.broadcaster.Stop()
+	if s.sysSampler != nil {
+		s.sysSampler.Stop()
+	}
+}
+
 // handleStats returns current statistics as JSON
 func (s *Server) handleStats(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
@@ -111,7 +187,17 @@ func (s *Server) handleStats(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	stats := s.dp.GetStats()
+	resp := s.buildStatsResponse(s.dp.GetStats())
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// buildStatsResponse turns a raw dataplane.Stats sample into the JSON
+// StatsResponse shape shared by the polled /api/stats endpoint and the
+// /api/stats/stream WebSocket feed.
+func (s *Server) buildStatsResponse(stats dataplane.Stats) StatsResponse {
 	elapsed := time.Since(s.startTime).Seconds()
 
 	pps := float64(0)
@@ -143,10 +229,91 @@ func (s *Server) handleStats(w http.ResponseWriter, r *http.Request) {
 	resp.Latency.MaxUs = stats.LatencyMax
 	resp.Latency.Count = stats.LatencyCount
 	resp.Latency.Enabled = stats.LatencyCount > 0
+	if stats.LatencyHistogram.Count() > 0 {
+		resp.Latency.Percentiles = map[string]float64{
+			"p50":   stats.LatencyHistogram.Percentile(50),
+			"p90":   stats.LatencyHistogram.Percentile(90),
+			"p99":   stats.LatencyHistogram.Percentile(99),
+			"p99.9": stats.LatencyHistogram.Percentile(99.9),
+		}
+	}
 
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Access-Control-Allow-Origin", "*")
-	json.NewEncoder(w).Encode(resp)
+	if s.sysSampler != nil {
+		sys := s.sysSampler.Latest()
+		resp.System = &SystemStatsResponse{
+			Load1:           sys.Load1,
+			Load5:           sys.Load5,
+			Load15:          sys.Load15,
+			CPUPercent:      sys.CPUPercent,
+			RSSBytes:        sys.RSSBytes,
+			CtxSwitches:     sys.CtxSwitches,
+			HostUptime:      sys.HostUptime,
+			NICName:         sys.NICName,
+			NICRxPackets:    sys.NICRxPackets,
+			NICTxPackets:    sys.NICTxPackets,
+			NICDriverQueues: sys.NICDriverQueues,
+		}
+	}
+
+	return resp
+}
+
+// handleStatsStream upgrades to a WebSocket and pushes a StatsResponse frame
+// each time the server's StatsBroadcaster ticks, until the client
+// disconnects or the server shuts down.
+func (s *Server) handleStatsStream(w http.ResponseWriter, r *http.Request) {
+	// Upgrade hijacks the connection and writes its own 101 response from
+	// responseHeader, never reading w.Header() back, so the CORS header has
+	// to be passed in here rather than set on w like the REST handlers do.
+	conn, err := upgrader.Upgrade(w, r, http.Header{"Access-Control-Allow-Origin": {"*"}})
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	ch := s.broadcaster.Subscribe()
+	defer s.broadcaster.Unsubscribe(ch)
+
+	conn.SetReadDeadline(time.Now().Add(statsStreamPongWait))
+	conn.SetPongHandler(func(string) error {
+		conn.SetReadDeadline(time.Now().Add(statsStreamPongWait))
+		return nil
+	})
+
+	// Drain and discard client reads; this also surfaces close frames so the
+	// write loop below can exit once the client goes away.
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	pingTicker := time.NewTicker(statsStreamPingInterval)
+	defer pingTicker.Stop()
+
+	for {
+		select {
+		case <-closed:
+			return
+		case <-pingTicker.C:
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		case stats, ok := <-ch:
+			if !ok {
+				// Broadcaster stopped (server shutting down).
+				conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, "server shutting down"))
+				return
+			}
+			if err := conn.WriteJSON(s.buildStatsResponse(stats)); err != nil {
+				return
+			}
+		}
+	}
 }
 
 // handleConfig returns current configuration
@@ -176,6 +343,65 @@ func (s *Server) handleConfig(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(resp)
 }
 
+// handleMetrics exposes the dataplane counters and latency distribution in
+// Prometheus text exposition format, alongside the JSON /api/stats endpoint.
+func (s *Server) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	stats := s.dp.GetStats()
+	cfg := s.dp.Config()
+	labels := fmt.Sprintf(`interface=%q,reflect_mode=%q`, cfg.Interface, cfg.Reflection.Mode)
+
+	var b strings.Builder
+	writeCounter(&b, "reflector_packets_received_total", "Total packets received on the monitored interface.", labels, stats.PacketsReceived)
+	writeCounter(&b, "reflector_packets_reflected_total", "Total packets reflected back to the sender.", labels, stats.PacketsReflected)
+	writeCounter(&b, "reflector_bytes_received_total", "Total bytes received on the monitored interface.", labels, stats.BytesReceived)
+	writeCounter(&b, "reflector_bytes_reflected_total", "Total bytes reflected back to the sender.", labels, stats.BytesReflected)
+	writeCounter(&b, "reflector_tx_errors_total", "Total transmit errors encountered while reflecting.", labels, stats.TxErrors)
+	writeCounter(&b, "reflector_rx_invalid_total", "Total received packets rejected as invalid.", labels, stats.RxInvalid)
+	writeCounter(&b, "reflector_sig_probeot_total", "Total packets matching the PROBEOT signature.", labels, stats.SigProbeOT)
+	writeCounter(&b, "reflector_sig_dataot_total", "Total packets matching the DATAOT signature.", labels, stats.SigDataOT)
+	writeCounter(&b, "reflector_sig_latency_total", "Total packets matching the LATENCY signature.", labels, stats.SigLatency)
+
+	if stats.LatencyHistogram.Count() > 0 {
+		writeMetricHeader(&b, "reflector_latency_microseconds", "Per-packet reflection latency in microseconds.", "histogram")
+		for _, us := range cfg.Stats.LatencyBucketsUs {
+			count := stats.LatencyHistogram.CumulativeCount(us * 1000.0)
+			fmt.Fprintf(&b, "reflector_latency_microseconds_bucket{%s,le=\"%g\"} %d\n", labels, us, count)
+		}
+		fmt.Fprintf(&b, "reflector_latency_microseconds_bucket{%s,le=\"+Inf\"} %d\n", labels, stats.LatencyHistogram.Count())
+		fmt.Fprintf(&b, "reflector_latency_microseconds_sum{%s} %f\n", labels, stats.LatencyAvg*float64(stats.LatencyCount))
+		fmt.Fprintf(&b, "reflector_latency_microseconds_count{%s} %d\n", labels, stats.LatencyCount)
+	} else {
+		// No histogram samples yet: fall back to a summary built from
+		// min/avg/max as quantiles 0, 0.5 and 1.
+		writeMetricHeader(&b, "reflector_latency_microseconds", "Per-packet reflection latency in microseconds.", "summary")
+		fmt.Fprintf(&b, "reflector_latency_microseconds{%s,quantile=\"0\"} %f\n", labels, stats.LatencyMin)
+		fmt.Fprintf(&b, "reflector_latency_microseconds{%s,quantile=\"0.5\"} %f\n", labels, stats.LatencyAvg)
+		fmt.Fprintf(&b, "reflector_latency_microseconds{%s,quantile=\"1\"} %f\n", labels, stats.LatencyMax)
+		fmt.Fprintf(&b, "reflector_latency_microseconds_sum{%s} %f\n", labels, stats.LatencyAvg*float64(stats.LatencyCount))
+		fmt.Fprintf(&b, "reflector_latency_microseconds_count{%s} %d\n", labels, stats.LatencyCount)
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Write([]byte(b.String()))
+}
+
+// writeMetricHeader writes the HELP/TYPE preamble for a metric name.
+func writeMetricHeader(b *strings.Builder, name, help, typ string) {
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, typ)
+}
+
+// writeCounter writes a single-sample counter metric with its HELP/TYPE preamble.
+func writeCounter(b *strings.Builder, name, help, labels string, value uint64) {
+	writeMetricHeader(b, name, help, "counter")
+	fmt.Fprintf(b, "%s{%s} %d\n", name, labels, value)
+}
+
 // handleHealth returns a simple health check
 func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
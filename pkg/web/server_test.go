@@ -0,0 +1,96 @@
+package web
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/krisarmstrong/reflector-native/pkg/config"
+	"github.com/krisarmstrong/reflector-native/pkg/dataplane"
+)
+
+// fakeDataplane is a minimal dataplaneSource for exercising handlers without
+// a real CGO-backed Dataplane, which can't be constructed in a unit test.
+type fakeDataplane struct {
+	stats   dataplane.Stats
+	cfg     *config.Config
+	running bool
+}
+
+func (f *fakeDataplane) GetStats() dataplane.Stats { return f.stats }
+func (f *fakeDataplane) Interface() string         { return f.cfg.Interface }
+func (f *fakeDataplane) IsRunning() bool           { return f.running }
+func (f *fakeDataplane) Config() *config.Config    { return f.cfg }
+func (f *fakeDataplane) StreamStats(ctx context.Context, interval time.Duration) <-chan dataplane.Stats {
+	ch := make(chan dataplane.Stats)
+	go func() {
+		defer close(ch)
+		<-ctx.Done()
+	}()
+	return ch
+}
+
+func newTestServer(t *testing.T, ifaceName string) *Server {
+	t.Helper()
+	cfg := &config.Config{
+		Interface:  ifaceName,
+		Reflection: config.ReflectConfig{Mode: "all"},
+		Stats: config.StatsConfig{
+			LatencyBucketsUs: []float64{10, 100, 1000},
+		},
+	}
+	fake := &fakeDataplane{
+		cfg:     cfg,
+		running: true,
+		stats: dataplane.Stats{
+			PacketsReceived:  100,
+			PacketsReflected: 90,
+			TxErrors:         1,
+		},
+	}
+	s := New(fake, 0)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestHandleMetricsFormat(t *testing.T) {
+	s := newTestServer(t, "eth0")
+
+	req := httptest.NewRequest("GET", "/metrics", nil)
+	rec := httptest.NewRecorder()
+	s.handleMetrics(rec, req)
+
+	body := rec.Body.String()
+	for _, want := range []string{
+		"# TYPE reflector_packets_received_total counter",
+		`reflector_packets_received_total{interface="eth0",reflect_mode="all"} 100`,
+		"# TYPE reflector_latency_microseconds summary",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("expected metrics output to contain %q, got:\n%s", want, body)
+		}
+	}
+}
+
+func TestHandleMetricsEscapesLabelValuesOnce(t *testing.T) {
+	s := newTestServer(t, `eth"0\test`)
+
+	req := httptest.NewRequest("GET", "/metrics", nil)
+	rec := httptest.NewRecorder()
+	s.handleMetrics(rec, req)
+
+	body := rec.Body.String()
+	want := `interface="eth\"0\\test"`
+	if !strings.Contains(body, want) {
+		t.Errorf("expected single-escaped label %q in output, got:\n%s", want, body)
+	}
+
+	// Regression guard for the double-escaping bug: %q re-escaping an
+	// already-escaped value would produce doubled backslashes here.
+	bad := `interface="eth\\\"0\\\\test"`
+	if strings.Contains(body, bad) {
+		t.Errorf("label value appears double-escaped: %s", body)
+	}
+}